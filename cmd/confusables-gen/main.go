@@ -21,7 +21,7 @@ const (
 )
 
 type Mapping struct {
-	Source     int    `json:"source"`
+	Source     []int  `json:"source"`
 	Target     []int  `json:"target"`
 	SourceName string `json:"source_name"`
 	TargetName string `json:"target_name"`
@@ -219,7 +219,7 @@ func parseConfusables(r io.Reader, sourceURL, version string) (*DataFile, error)
 		}
 
 		dataFile.Mappings = append(dataFile.Mappings, Mapping{
-			Source:     int(sourceRune),
+			Source:     []int{int(sourceRune)},
 			Target:     targetRunes,
 			SourceName: sourceName,
 			TargetName: targetName,
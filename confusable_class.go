@@ -0,0 +1,52 @@
+package confusables
+
+// ConfusableClass identifies which of the UTS #39 §4 detection tests a
+// pair of strings satisfies.
+type ConfusableClass int
+
+const (
+	// NotConfusable means a and b have distinct skeletons.
+	NotConfusable ConfusableClass = iota
+	// SingleScriptConfusable means a and b share a skeleton and, taken
+	// together, use at most one script.
+	SingleScriptConfusable
+	// WholeScriptConfusable means a and b share a skeleton, each is
+	// single-script on its own, but their scripts differ.
+	WholeScriptConfusable
+	// MixedScriptConfusable means a and b share a skeleton but together
+	// span more than one script in a way that isn't Whole-Script.
+	MixedScriptConfusable
+)
+
+// String returns the name used for this class in UTS #39.
+func (c ConfusableClass) String() string {
+	switch c {
+	case SingleScriptConfusable:
+		return "SingleScriptConfusable"
+	case WholeScriptConfusable:
+		return "WholeScriptConfusable"
+	case MixedScriptConfusable:
+		return "MixedScriptConfusable"
+	default:
+		return "NotConfusable"
+	}
+}
+
+// ConfusableType classifies the relationship between a and b per UTS #39
+// §4: Single-Script, Whole-Script, and Mixed-Script Confusables.
+func (db *DB) ConfusableType(a, b string) ConfusableClass {
+	if db.Skeleton(a) != db.Skeleton(b) {
+		return NotConfusable
+	}
+
+	scriptsA := runeScripts(a)
+	scriptsB := runeScripts(b)
+
+	if len(unionScripts(scriptsA, scriptsB)) <= 1 {
+		return SingleScriptConfusable
+	}
+	if len(scriptsA) <= 1 && len(scriptsB) <= 1 {
+		return WholeScriptConfusable
+	}
+	return MixedScriptConfusable
+}
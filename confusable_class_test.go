@@ -0,0 +1,41 @@
+package confusables
+
+import "testing"
+
+func TestConfusableType(t *testing.T) {
+	db := Default()
+
+	tests := []struct {
+		a, b string
+		want ConfusableClass
+	}{
+		{"different", "strings", NotConfusable},
+		{"hello", "hello", SingleScriptConfusable},
+		{"paypal", "pаypаl", MixedScriptConfusable}, // Latin vs Latin+Cyrillic
+		{"123", "l23", SingleScriptConfusable},       // Common digits + Latin 'l' resolve to one script
+	}
+
+	for _, tt := range tests {
+		if got := db.ConfusableType(tt.a, tt.b); got != tt.want {
+			t.Errorf("ConfusableType(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestConfusableClassString(t *testing.T) {
+	tests := []struct {
+		c    ConfusableClass
+		want string
+	}{
+		{NotConfusable, "NotConfusable"},
+		{SingleScriptConfusable, "SingleScriptConfusable"},
+		{WholeScriptConfusable, "WholeScriptConfusable"},
+		{MixedScriptConfusable, "MixedScriptConfusable"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.c.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -6,18 +6,51 @@ import (
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"golang.org/x/text/unicode/norm"
 )
 
-// Mapping represents a single confusable mapping.
+// Mapping represents a single confusable mapping. Source is usually a
+// single codepoint but may be a sequence, matching UTS #39's allowance
+// for multi-character source keys.
 type Mapping struct {
-	Source     int    `json:"source"`
+	Source     []int  `json:"source"`
 	Target     []int  `json:"target"`
 	SourceName string `json:"source_name"`
 	TargetName string `json:"target_name"`
 }
 
+// UnmarshalJSON accepts "source" as either a single integer (the
+// original single-rune format) or an array of integers, so data
+// generated before multi-rune sources were supported keeps loading.
+func (m *Mapping) UnmarshalJSON(data []byte) error {
+	var alias struct {
+		Source     json.RawMessage `json:"source"`
+		Target     []int           `json:"target"`
+		SourceName string          `json:"source_name"`
+		TargetName string          `json:"target_name"`
+	}
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return err
+	}
+
+	var source []int
+	if err := json.Unmarshal(alias.Source, &source); err != nil {
+		var single int
+		if err := json.Unmarshal(alias.Source, &single); err != nil {
+			return fmt.Errorf("source must be an integer or array of integers: %w", err)
+		}
+		source = []int{single}
+	}
+
+	m.Source = source
+	m.Target = alias.Target
+	m.SourceName = alias.SourceName
+	m.TargetName = alias.TargetName
+	return nil
+}
+
 // dataFile is the internal representation of the JSON structure.
 type dataFile struct {
 	UnicodeVersion string    `json:"unicode_version"`
@@ -27,9 +60,17 @@ type dataFile struct {
 	Mappings       []Mapping `json:"mappings"`
 }
 
+// node is a trie node used to match source rune sequences left to right.
+// targets is non-nil at nodes that terminate a mapping.
+type node struct {
+	children map[rune]*node
+	targets  []rune
+}
+
 // DB is the confusables database. Thread-safe after initialization.
 type DB struct {
-	mappings       map[rune][]rune
+	mappings       map[rune][]rune // single-rune sources, for Lookup/LookupASCII
+	root           *node           // trie over all sources, for Skeleton/LookupSequence
 	unicodeVersion string
 	sourceDate     string
 	generatedAt    time.Time
@@ -41,6 +82,14 @@ var (
 	once      sync.Once
 )
 
+// skeletonBufPool reduces allocation churn in Skeleton's hot loop.
+var skeletonBufPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 64)
+		return &b
+	},
+}
+
 // Default returns the embedded database (loaded once via sync.Once).
 func Default() *DB {
 	once.Do(func() {
@@ -63,6 +112,7 @@ func Load(jsonData []byte) (*DB, error) {
 
 	db := &DB{
 		mappings:       make(map[rune][]rune, len(df.Mappings)),
+		root:           &node{children: make(map[rune]*node)},
 		unicodeVersion: df.UnicodeVersion,
 		sourceDate:     df.SourceDate,
 		generatedAt:    df.GeneratedAt,
@@ -70,17 +120,22 @@ func Load(jsonData []byte) (*DB, error) {
 	}
 
 	for _, m := range df.Mappings {
-		if len(m.Target) == 0 {
-			return nil, fmt.Errorf("invalid mapping for rune %04X: empty target", m.Source)
+		if len(m.Source) == 0 {
+			return nil, fmt.Errorf("invalid mapping: empty source")
 		}
-		// Validate raw int before conversion to rune to avoid wrap-around truncation
-		if m.Source < 0 || m.Source > 0x10FFFF || (m.Source >= 0xD800 && m.Source <= 0xDFFF) {
-			return nil, fmt.Errorf("invalid unicode source codepoint: %04X", m.Source)
+		if len(m.Target) == 0 {
+			return nil, fmt.Errorf("invalid mapping for rune %04X: empty target", m.Source[0])
 		}
-		source := rune(m.Source)
-		if _, exists := db.mappings[source]; exists {
-			return nil, fmt.Errorf("duplicate mapping for rune %04X", m.Source)
+
+		// Validate raw ints before conversion to rune to avoid wrap-around truncation
+		source := make([]rune, len(m.Source))
+		for i, s := range m.Source {
+			if s < 0 || s > 0x10FFFF || (s >= 0xD800 && s <= 0xDFFF) {
+				return nil, fmt.Errorf("invalid unicode source codepoint: %04X", s)
+			}
+			source[i] = rune(s)
 		}
+
 		// defensive copy and conversion to rune
 		targets := make([]rune, len(m.Target))
 		for i, t := range m.Target {
@@ -89,7 +144,24 @@ func Load(jsonData []byte) (*DB, error) {
 			}
 			targets[i] = rune(t)
 		}
-		db.mappings[source] = targets
+
+		n := db.root
+		for _, r := range source {
+			child, ok := n.children[r]
+			if !ok {
+				child = &node{children: make(map[rune]*node)}
+				n.children[r] = child
+			}
+			n = child
+		}
+		if n.targets != nil {
+			return nil, fmt.Errorf("duplicate mapping for rune %04X", m.Source[0])
+		}
+		n.targets = targets
+
+		if len(source) == 1 {
+			db.mappings[source[0]] = targets
+		}
 	}
 
 	return db, nil
@@ -137,28 +209,70 @@ func (db *DB) ToASCII(s string) string {
 // Skeleton returns the TR39 skeleton of the string.
 // Maps all confusable characters through the database, regardless of target length.
 // Result is NOT suitable for display — use only for comparison.
-// Implementation: NFD → map → NFD
+// Implementation: NFD → map (longest-match over source sequences) → NFD
 func (db *DB) Skeleton(s string) string {
-	// 1. NFD
-	s = norm.NFD.String(s)
+	// NFD is a no-op on ASCII, so ASCII input skips the NFD passes. It
+	// still goes through the mapping: ASCII runes such as '1' appear on
+	// the source side of mappings (see LookupASCII).
+	if !isASCIIOnly(s) {
+		s = norm.NFD.String(s)
+	}
+	rs := []rune(s)
 
-	// 2. Map
-	var b strings.Builder
-	for _, r := range s {
-		if targets, ok := db.mappings[r]; ok {
+	bufPtr := skeletonBufPool.Get().(*[]byte)
+	buf := (*bufPtr)[:0]
+
+	for i := 0; i < len(rs); {
+		if targets, n, ok := db.LookupSequence(rs[i:]); ok {
 			for _, tr := range targets {
-				b.WriteRune(tr)
+				buf = utf8.AppendRune(buf, tr)
 			}
+			i += n
 		} else {
-			b.WriteRune(r)
+			buf = utf8.AppendRune(buf, rs[i])
+			i++
 		}
 	}
-	s = b.String()
 
-	// 3. NFD again
+	s = string(buf)
+	*bufPtr = buf
+	skeletonBufPool.Put(bufPtr)
+
+	if isASCIIOnly(s) {
+		return s
+	}
 	return norm.NFD.String(s)
 }
 
+// LookupSequence matches the longest prefix of rs against the database's
+// source sequences and returns its target runes along with the number of
+// runes consumed. Returns (nil, 0, false) if no prefix of rs matches.
+func (db *DB) LookupSequence(rs []rune) ([]rune, int, bool) {
+	n := db.root
+	var targets []rune
+	matched := 0
+
+	for i, r := range rs {
+		child, ok := n.children[r]
+		if !ok {
+			break
+		}
+		n = child
+		if n.targets != nil {
+			targets = n.targets
+			matched = i + 1
+		}
+	}
+
+	if matched == 0 {
+		return nil, 0, false
+	}
+
+	res := make([]rune, len(targets))
+	copy(res, targets)
+	return res, matched, true
+}
+
 // IsConfusable checks if two strings would produce the same skeleton.
 func (db *DB) IsConfusable(a, b string) bool {
 	return db.Skeleton(a) == db.Skeleton(b)
@@ -101,7 +101,7 @@ func TestLoadErrors(t *testing.T) {
 			name: "Empty target",
 			data: dataFile{
 				Mappings: []Mapping{
-					{Source: 0x41, Target: []int{}},
+					{Source: []int{0x41}, Target: []int{}},
 				},
 			},
 			wantErr: "empty target",
@@ -110,8 +110,8 @@ func TestLoadErrors(t *testing.T) {
 			name: "Duplicate source",
 			data: dataFile{
 				Mappings: []Mapping{
-					{Source: 0x41, Target: []int{0x41}},
-					{Source: 0x41, Target: []int{0x42}},
+					{Source: []int{0x41}, Target: []int{0x41}},
+					{Source: []int{0x41}, Target: []int{0x42}},
 				},
 			},
 			wantErr: "duplicate mapping",
@@ -120,7 +120,7 @@ func TestLoadErrors(t *testing.T) {
 			name: "Invalid source Unicode",
 			data: dataFile{
 				Mappings: []Mapping{
-					{Source: 0xD800, Target: []int{0x41}},
+					{Source: []int{0xD800}, Target: []int{0x41}},
 				},
 			},
 			wantErr: "invalid unicode source",
@@ -129,7 +129,7 @@ func TestLoadErrors(t *testing.T) {
 			name: "Invalid target Unicode",
 			data: dataFile{
 				Mappings: []Mapping{
-					{Source: 0x41, Target: []int{0xD800}},
+					{Source: []int{0x41}, Target: []int{0xD800}},
 				},
 			},
 			wantErr: "invalid unicode target",
@@ -138,7 +138,7 @@ func TestLoadErrors(t *testing.T) {
 			name: "Oversized target Unicode (wrap-around)",
 			data: dataFile{
 				Mappings: []Mapping{
-					{Source: 0x41, Target: []int{4294967361}}, // 0x100000041, wraps to 0x41 ('A') if cast directly
+					{Source: []int{0x41}, Target: []int{4294967361}}, // 0x100000041, wraps to 0x41 ('A') if cast directly
 				},
 			},
 			wantErr: "invalid unicode target",
@@ -172,6 +172,79 @@ func TestMetadata(t *testing.T) {
 	}
 }
 
+func TestMultiRuneSource(t *testing.T) {
+	// "rn" is a classic two-rune sequence confusable with "m".
+	jsonData, _ := json.Marshal(dataFile{
+		Mappings: []Mapping{
+			{Source: []int{'r', 'n'}, Target: []int{'m'}},
+		},
+	})
+	db, err := Load(jsonData)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if got := db.Skeleton("barn"); got != "bam" {
+		t.Errorf("Skeleton(%q) = %q, want %q", "barn", got, "bam")
+	}
+	if !db.IsConfusable("barn", "bam") {
+		t.Error("IsConfusable(\"barn\", \"bam\") = false, want true")
+	}
+
+	target, n, ok := db.LookupSequence([]rune("rnaway"))
+	if !ok || n != 2 || string(target) != "m" {
+		t.Errorf("LookupSequence(%q) = %q, %d, %v, want %q, 2, true", "rnaway", string(target), n, ok, "m")
+	}
+
+	if _, _, ok := db.LookupSequence([]rune("away")); ok {
+		t.Error("LookupSequence(\"away\") matched, want no match")
+	}
+}
+
+func TestBackwardCompatSingleIntSource(t *testing.T) {
+	// Older generated data encodes "source" as a bare integer rather
+	// than an array; Load must still accept it.
+	jsonData := []byte(`{"mappings":[{"source":97,"target":[98]}]}`)
+	db, err := Load(jsonData)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := db.Lookup('a'); len(got) != 1 || got[0] != 'b' {
+		t.Errorf("Lookup('a') = %v, want [98]", got)
+	}
+}
+
+func TestSkeletonMatchesSingleRuneLookup(t *testing.T) {
+	db := Default()
+	tests := []string{"hello", "hеllо", "viagra", "vіаgrа", "123", "l23"}
+
+	for _, s := range tests {
+		var want strings.Builder
+		for _, r := range s {
+			if targets := db.Lookup(r); targets != nil {
+				for _, tr := range targets {
+					want.WriteRune(tr)
+				}
+			} else {
+				want.WriteRune(r)
+			}
+		}
+		// Skeleton also runs the result through NFD, so compare via
+		// IsConfusable's own notion of equality rather than raw strings.
+		if got := db.Skeleton(s); got != db.Skeleton(want.String()) {
+			t.Errorf("Skeleton(%q) = %q, not consistent with per-rune Lookup result %q", s, got, want.String())
+		}
+	}
+}
+
+func BenchmarkSkeleton(b *testing.B) {
+	db := Default()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = db.Skeleton("vіаgrа pаypаl hеllo wоrld")
+	}
+}
+
 func TestConcurrency(_ *testing.T) {
 	db := Default()
 	const (
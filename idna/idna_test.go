@@ -0,0 +1,123 @@
+package idna
+
+import (
+	"testing"
+
+	"github.com/disciplinedware/go-confusables"
+)
+
+func TestIsConfusableDomain(t *testing.T) {
+	c := New(confusables.Default())
+
+	tests := []struct {
+		name       string
+		a, b       string
+		wantConf   bool
+		wantReason Reason
+	}{
+		{
+			name:       "known IDN homograph",
+			a:          "apple.com",
+			b:          "аррle.com", // Cyrillic а, р, р
+			wantConf:   true,
+			wantReason: ReasonMixedScript,
+		},
+		{
+			name:       "paypal homograph",
+			a:          "paypal.com",
+			b:          "pаypаl.com", // Cyrillic а
+			wantConf:   true,
+			wantReason: ReasonMixedScript,
+		},
+		{
+			name:       "case-only difference",
+			a:          "apple.com",
+			b:          "APPLE.com",
+			wantConf:   true,
+			wantReason: ReasonSkeletonMatch,
+		},
+		{
+			name:       "unrelated domains",
+			a:          "apple.com",
+			b:          "google.com",
+			wantConf:   false,
+			wantReason: ReasonNone,
+		},
+		{
+			name:       "different label count",
+			a:          "apple.com",
+			b:          "www.apple.com",
+			wantConf:   false,
+			wantReason: ReasonLabelCountMismatch,
+		},
+		{
+			name:       "invalid punycode",
+			a:          "apple.com",
+			b:          "xn--.com",
+			wantConf:   false,
+			wantReason: ReasonInvalidPunycode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotConf, gotReason := c.IsConfusableDomain(tt.a, tt.b)
+			if gotConf != tt.wantConf || gotReason != tt.wantReason {
+				t.Errorf("IsConfusableDomain(%q, %q) = (%v, %v), want (%v, %v)",
+					tt.a, tt.b, gotConf, gotReason, tt.wantConf, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestReasonString(t *testing.T) {
+	tests := []struct {
+		r    Reason
+		want string
+	}{
+		{ReasonNone, "ReasonNone"},
+		{ReasonSkeletonMatch, "ReasonSkeletonMatch"},
+		{ReasonMixedScript, "ReasonMixedScript"},
+		{ReasonInvalidPunycode, "ReasonInvalidPunycode"},
+		{ReasonLabelCountMismatch, "ReasonLabelCountMismatch"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.r.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeDomain(t *testing.T) {
+	c := New(confusables.Default())
+
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{name: "lowercases ASCII", in: "ExAmple.COM", want: "example.com"},
+		{name: "punycode-encodes unicode labels", in: "münchen.de", want: "xn--mnchen-3ya.de"},
+		{name: "invalid label errors", in: "xn--.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.NormalizeDomain(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NormalizeDomain(%q) error = nil, want error", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeDomain(%q) error = %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeDomain(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package confusables
+
+// RestrictionLevel is one of the UTS #39 §5.2 identifier restriction
+// levels, ordered from most to least restrictive.
+type RestrictionLevel int
+
+const (
+	// ASCIIOnly means every rune is in the ASCII range (U+0000-U+007F).
+	ASCIIOnly RestrictionLevel = iota
+	// SingleScript means the string uses at most one script.
+	SingleScript
+	// HighlyRestrictive means the string's non-Latin scripts fall inside
+	// one of the CJK-adjacent groups UTS #39 recommends (Han + Hiragana +
+	// Katakana, Han + Bopomofo, or Han + Hangul), with Latin optional.
+	HighlyRestrictive
+	// ModeratelyRestrictive means the string is Latin combined with one
+	// other script, excluding Cyrillic and Greek.
+	ModeratelyRestrictive
+	// MinimallyRestrictive means the string mixes scripts in a way that
+	// doesn't fit the levels above but avoids mixing Latin with Cyrillic
+	// or Greek, the pairing most homograph attacks rely on.
+	MinimallyRestrictive
+	// Unrestricted means none of the levels above apply.
+	Unrestricted
+)
+
+// String returns the name used for this level in UTS #39.
+func (l RestrictionLevel) String() string {
+	switch l {
+	case ASCIIOnly:
+		return "ASCIIOnly"
+	case SingleScript:
+		return "SingleScript"
+	case HighlyRestrictive:
+		return "HighlyRestrictive"
+	case ModeratelyRestrictive:
+		return "ModeratelyRestrictive"
+	case MinimallyRestrictive:
+		return "MinimallyRestrictive"
+	default:
+		return "Unrestricted"
+	}
+}
+
+// highlyRestrictiveGroups are the closed script sets UTS #39 table 4
+// allows alongside (optional) Latin for the Highly Restrictive level.
+var highlyRestrictiveGroups = []map[string]bool{
+	{"Han": true, "Hiragana": true, "Katakana": true},
+	{"Han": true, "Bopomofo": true},
+	{"Han": true, "Hangul": true},
+}
+
+// dangerousWithLatin are scripts UTS #39 recommends never mixing with
+// Latin outside the groups above — the pairing behind most homograph
+// attacks.
+var dangerousWithLatin = map[string]bool{
+	"Cyrillic": true,
+	"Greek":    true,
+}
+
+// RestrictionLevel classifies s per UTS #39 §5.2.
+func (db *DB) RestrictionLevel(s string) RestrictionLevel {
+	if isASCIIOnly(s) {
+		return ASCIIOnly
+	}
+
+	scripts := runeScripts(s)
+	if len(scripts) <= 1 {
+		return SingleScript
+	}
+
+	nonLatin := withoutScripts(scripts, "Latin")
+	for _, group := range highlyRestrictiveGroups {
+		if len(nonLatin) > 0 && isSubset(nonLatin, group) {
+			return HighlyRestrictive
+		}
+	}
+
+	if scripts["Latin"] && len(scripts) == 2 {
+		for sc := range scripts {
+			if sc != "Latin" && !dangerousWithLatin[sc] {
+				return ModeratelyRestrictive
+			}
+		}
+	}
+
+	if scripts["Latin"] {
+		for sc := range scripts {
+			if dangerousWithLatin[sc] {
+				return Unrestricted
+			}
+		}
+	}
+
+	return MinimallyRestrictive
+}
+
+func isASCIIOnly(s string) bool {
+	for _, r := range s {
+		if r > 0x7F {
+			return false
+		}
+	}
+	return true
+}
+
+func withoutScripts(scripts map[string]bool, exclude ...string) map[string]bool {
+	excl := make(map[string]bool, len(exclude))
+	for _, e := range exclude {
+		excl[e] = true
+	}
+	rest := make(map[string]bool, len(scripts))
+	for sc := range scripts {
+		if !excl[sc] {
+			rest[sc] = true
+		}
+	}
+	return rest
+}
+
+func isSubset(scripts, of map[string]bool) bool {
+	for sc := range scripts {
+		if !of[sc] {
+			return false
+		}
+	}
+	return true
+}
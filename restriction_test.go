@@ -0,0 +1,49 @@
+package confusables
+
+import "testing"
+
+func TestRestrictionLevel(t *testing.T) {
+	db := Default()
+
+	tests := []struct {
+		name string
+		s    string
+		want RestrictionLevel
+	}{
+		{"ASCII", "hello", ASCIIOnly},
+		{"single script Cyrillic", "привет", SingleScript},
+		{"Latin+Han+Hiragana", "ab漢あ", HighlyRestrictive},
+		{"Latin+Han+Hangul", "ab漢한", HighlyRestrictive},
+		{"Latin+Armenian", "abաբ", ModeratelyRestrictive},
+		{"Latin+Cyrillic", "hеllo", Unrestricted},
+		{"Latin+Cyrillic+Greek", "hеlloα", Unrestricted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := db.RestrictionLevel(tt.s); got != tt.want {
+				t.Errorf("RestrictionLevel(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestrictionLevelString(t *testing.T) {
+	tests := []struct {
+		l    RestrictionLevel
+		want string
+	}{
+		{ASCIIOnly, "ASCIIOnly"},
+		{SingleScript, "SingleScript"},
+		{HighlyRestrictive, "HighlyRestrictive"},
+		{ModeratelyRestrictive, "ModeratelyRestrictive"},
+		{MinimallyRestrictive, "MinimallyRestrictive"},
+		{Unrestricted, "Unrestricted"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.l.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
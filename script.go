@@ -0,0 +1,63 @@
+package confusables
+
+import "unicode"
+
+// scriptTables lists the Unicode scripts this package distinguishes when
+// resolving the script of a rune, checked in order. Common and Inherited
+// are listed last because they carry no script-mixing signal of their
+// own — they're compatible with every script (UTS #39 §5.1).
+var scriptTables = []struct {
+	name  string
+	table *unicode.RangeTable
+}{
+	{"Latin", unicode.Latin},
+	{"Cyrillic", unicode.Cyrillic},
+	{"Greek", unicode.Greek},
+	{"Armenian", unicode.Armenian},
+	{"Hebrew", unicode.Hebrew},
+	{"Arabic", unicode.Arabic},
+	{"Han", unicode.Han},
+	{"Hiragana", unicode.Hiragana},
+	{"Katakana", unicode.Katakana},
+	{"Hangul", unicode.Hangul},
+	{"Bopomofo", unicode.Bopomofo},
+	{"Common", unicode.Common},
+	{"Inherited", unicode.Inherited},
+}
+
+// scriptOf returns the Unicode script of r, or "" if r falls outside
+// every script in scriptTables.
+func scriptOf(r rune) string {
+	for _, s := range scriptTables {
+		if unicode.Is(s.table, r) {
+			return s.name
+		}
+	}
+	return ""
+}
+
+// runeScripts returns the set of scripts present in s, excluding Common
+// and Inherited. Runes whose script is not listed in scriptTables are
+// skipped, so their scripts are not in the set.
+func runeScripts(s string) map[string]bool {
+	scripts := make(map[string]bool)
+	for _, r := range s {
+		sc := scriptOf(r)
+		if sc == "" || sc == "Common" || sc == "Inherited" {
+			continue
+		}
+		scripts[sc] = true
+	}
+	return scripts
+}
+
+func unionScripts(a, b map[string]bool) map[string]bool {
+	u := make(map[string]bool, len(a)+len(b))
+	for s := range a {
+		u[s] = true
+	}
+	for s := range b {
+		u[s] = true
+	}
+	return u
+}
@@ -0,0 +1,236 @@
+package confusables
+
+import (
+	"sync"
+	"unicode/utf8"
+)
+
+// Match describes a registered corpus entry whose skeleton matched a query.
+type Match struct {
+	Original string
+	Tag      string
+}
+
+type skeletonEntry struct {
+	original string
+	tag      string
+}
+
+// skeletonStore holds the skeleton → entries map and the rune-length
+// bounds used to scope substring scans. It's shared by SkeletonIndex
+// (mutable, lock-guarded) and SkeletonSnapshot (immutable, unguarded).
+type skeletonStore struct {
+	db      *DB
+	entries map[string][]skeletonEntry
+	minLen  int
+	maxLen  int
+}
+
+func (s *skeletonStore) lookup(query string) []Match {
+	return matchesFor(s.entries[s.db.Skeleton(query)])
+}
+
+func (s *skeletonStore) length() int {
+	n := 0
+	for _, e := range s.entries {
+		n += len(e)
+	}
+	return n
+}
+
+// scanWindows slides a rune window of length [minLen, maxLen] across
+// query, computing the skeleton of each window and checking it against
+// entries. Bounding the window to the registered corpus's own length
+// range keeps this well short of the naive O(n²) every-substring scan.
+func (s *skeletonStore) scanWindows(query string, prefixOnly bool) []Match {
+	if s.maxLen == 0 {
+		return nil
+	}
+
+	rs := []rune(query)
+	maxLen := s.maxLen
+	if maxLen > len(rs) {
+		maxLen = len(rs)
+	}
+
+	maxStart := len(rs) - s.minLen
+	if prefixOnly {
+		maxStart = 0
+	}
+
+	seen := make(map[string]bool)
+	var matches []Match
+	for start := 0; start <= maxStart; start++ {
+		hi := start + maxLen
+		if hi > len(rs) {
+			hi = len(rs)
+		}
+		for end := start + s.minLen; end <= hi; end++ {
+			entries, ok := s.entries[s.db.Skeleton(string(rs[start:end]))]
+			if !ok {
+				continue
+			}
+			for _, e := range entries {
+				key := e.original + "\x00" + e.tag
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				matches = append(matches, Match{Original: e.original, Tag: e.tag})
+			}
+		}
+	}
+	return matches
+}
+
+func matchesFor(entries []skeletonEntry) []Match {
+	if len(entries) == 0 {
+		return nil
+	}
+	matches := make([]Match, len(entries))
+	for i, e := range entries {
+		matches[i] = Match{Original: e.original, Tag: e.tag}
+	}
+	return matches
+}
+
+// SkeletonIndex detects strings confusable with a registered corpus of
+// protected identifiers — usernames, domain labels, package names, and
+// the like. Safe for concurrent use.
+type SkeletonIndex struct {
+	mu    sync.RWMutex
+	store skeletonStore
+}
+
+// NewSkeletonIndex creates an empty index backed by db.
+func (db *DB) NewSkeletonIndex() *SkeletonIndex {
+	return &SkeletonIndex{
+		store: skeletonStore{
+			db:      db,
+			entries: make(map[string][]skeletonEntry),
+		},
+	}
+}
+
+// Add registers original under its skeleton, associated with tag.
+func (idx *SkeletonIndex) Add(original, tag string) {
+	skel := idx.store.db.Skeleton(original)
+	n := utf8.RuneCountInString(original)
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.store.entries[skel] = append(idx.store.entries[skel], skeletonEntry{original: original, tag: tag})
+	if idx.store.minLen == 0 || n < idx.store.minLen {
+		idx.store.minLen = n
+	}
+	if n > idx.store.maxLen {
+		idx.store.maxLen = n
+	}
+}
+
+// AddAll registers every string in originals under tag.
+func (idx *SkeletonIndex) AddAll(tag string, originals ...string) {
+	for _, o := range originals {
+		idx.Add(o, tag)
+	}
+}
+
+// Remove removes the (original, tag) entry, if present, and is a no-op
+// otherwise. It does not shrink the length bounds used by
+// LookupPrefix/LookupSubstring, so removing the corpus's sole
+// shortest or longest entry leaves those scans a little wider than
+// strictly necessary rather than paying to rescan the whole index.
+func (idx *SkeletonIndex) Remove(original, tag string) {
+	skel := idx.store.db.Skeleton(original)
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	entries := idx.store.entries[skel]
+	for i, e := range entries {
+		if e.original == original && e.tag == tag {
+			idx.store.entries[skel] = append(entries[:i:i], entries[i+1:]...)
+			break
+		}
+	}
+	if len(idx.store.entries[skel]) == 0 {
+		delete(idx.store.entries, skel)
+	}
+}
+
+// Len returns the number of registered entries.
+func (idx *SkeletonIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.store.length()
+}
+
+// Lookup returns every registered entry whose skeleton matches query.
+func (idx *SkeletonIndex) Lookup(query string) []Match {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.store.lookup(query)
+}
+
+// LookupPrefix returns matches for registered entries confusable with a
+// leading substring of query.
+func (idx *SkeletonIndex) LookupPrefix(query string) []Match {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.store.scanWindows(query, true)
+}
+
+// LookupSubstring scans query for confusable substrings anywhere inside
+// it, not just at the start — useful for spam/abuse pipelines scanning
+// message bodies for embedded brand impersonation.
+func (idx *SkeletonIndex) LookupSubstring(query string) []Match {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.store.scanWindows(query, false)
+}
+
+// Snapshot returns an immutable, read-only view of the index suitable
+// for read-heavy workloads that shouldn't pay for RWMutex contention.
+func (idx *SkeletonIndex) Snapshot() *SkeletonSnapshot {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	entries := make(map[string][]skeletonEntry, len(idx.store.entries))
+	for skel, e := range idx.store.entries {
+		cp := make([]skeletonEntry, len(e))
+		copy(cp, e)
+		entries[skel] = cp
+	}
+	return &SkeletonSnapshot{store: skeletonStore{
+		db:      idx.store.db,
+		entries: entries,
+		minLen:  idx.store.minLen,
+		maxLen:  idx.store.maxLen,
+	}}
+}
+
+// SkeletonSnapshot is a point-in-time, read-only copy of a SkeletonIndex.
+// It has no mutex and is safe for concurrent use by multiple goroutines.
+type SkeletonSnapshot struct {
+	store skeletonStore
+}
+
+// Lookup returns every entry whose skeleton matches query.
+func (s *SkeletonSnapshot) Lookup(query string) []Match {
+	return s.store.lookup(query)
+}
+
+// LookupPrefix returns matches for entries confusable with a leading
+// substring of query.
+func (s *SkeletonSnapshot) LookupPrefix(query string) []Match {
+	return s.store.scanWindows(query, true)
+}
+
+// LookupSubstring scans query for confusable substrings anywhere inside it.
+func (s *SkeletonSnapshot) LookupSubstring(query string) []Match {
+	return s.store.scanWindows(query, false)
+}
+
+// Len returns the number of entries captured in the snapshot.
+func (s *SkeletonSnapshot) Len() int {
+	return s.store.length()
+}
@@ -0,0 +1,137 @@
+package confusables
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSkeletonIndexLookup(t *testing.T) {
+	db := Default()
+	idx := db.NewSkeletonIndex()
+	idx.Add("paypal", "id-1")
+	idx.Add("google", "id-2")
+
+	t.Run("confusable match", func(t *testing.T) {
+		matches := idx.Lookup("pаypаl") // Cyrillic 'а'
+		if len(matches) != 1 || matches[0].Original != "paypal" || matches[0].Tag != "id-1" {
+			t.Errorf("Lookup(%q) = %+v, want [{paypal id-1}]", "pаypаl", matches)
+		}
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		if matches := idx.Lookup("amazon"); matches != nil {
+			t.Errorf("Lookup(%q) = %+v, want nil", "amazon", matches)
+		}
+	})
+
+	t.Run("multiple tags for the same skeleton", func(t *testing.T) {
+		idx.Add("paypal", "id-3")
+		matches := idx.Lookup("paypal")
+		if len(matches) != 2 {
+			t.Fatalf("Lookup(%q) returned %d matches, want 2", "paypal", len(matches))
+		}
+	})
+}
+
+func TestSkeletonIndexAddAllAndRemove(t *testing.T) {
+	db := Default()
+	idx := db.NewSkeletonIndex()
+	idx.AddAll("brand", "paypal", "google", "amazon")
+
+	if got := idx.Len(); got != 3 {
+		t.Fatalf("Len() = %d, want 3", got)
+	}
+
+	idx.Remove("google", "brand")
+	if got := idx.Len(); got != 2 {
+		t.Fatalf("Len() after Remove = %d, want 2", got)
+	}
+	if matches := idx.Lookup("google"); matches != nil {
+		t.Errorf("Lookup(%q) after Remove = %+v, want nil", "google", matches)
+	}
+
+	// Removing an entry that was never added is a no-op.
+	idx.Remove("google", "brand")
+	if got := idx.Len(); got != 2 {
+		t.Errorf("Len() after redundant Remove = %d, want 2", got)
+	}
+}
+
+func TestSkeletonIndexSnapshot(t *testing.T) {
+	db := Default()
+	idx := db.NewSkeletonIndex()
+	idx.Add("paypal", "id-1")
+
+	snap := idx.Snapshot()
+	if got := snap.Len(); got != 1 {
+		t.Fatalf("Snapshot Len() = %d, want 1", got)
+	}
+	if matches := snap.Lookup("pаypаl"); len(matches) != 1 {
+		t.Fatalf("Snapshot Lookup(%q) = %+v, want 1 match", "pаypаl", matches)
+	}
+
+	// Mutating the live index after taking a snapshot must not affect it.
+	idx.Add("google", "id-2")
+	if got := snap.Len(); got != 1 {
+		t.Errorf("Snapshot Len() after later Add = %d, want 1 (unaffected)", got)
+	}
+}
+
+func TestSkeletonIndexLookupPrefixAndSubstring(t *testing.T) {
+	db := Default()
+	idx := db.NewSkeletonIndex()
+	idx.Add("paypal", "brand")
+
+	t.Run("LookupPrefix matches a leading confusable substring", func(t *testing.T) {
+		matches := idx.LookupPrefix("pаypаl-login.example.com")
+		if len(matches) != 1 || matches[0].Original != "paypal" {
+			t.Errorf("LookupPrefix(...) = %+v, want a single paypal match", matches)
+		}
+	})
+
+	t.Run("LookupPrefix ignores a non-leading match", func(t *testing.T) {
+		if matches := idx.LookupPrefix("login-pаypаl.example.com"); matches != nil {
+			t.Errorf("LookupPrefix(...) = %+v, want nil", matches)
+		}
+	})
+
+	t.Run("LookupSubstring finds an embedded confusable", func(t *testing.T) {
+		matches := idx.LookupSubstring("login-pаypаl.example.com")
+		if len(matches) != 1 || matches[0].Original != "paypal" {
+			t.Errorf("LookupSubstring(...) = %+v, want a single paypal match", matches)
+		}
+	})
+
+	t.Run("LookupSubstring on a clean string", func(t *testing.T) {
+		if matches := idx.LookupSubstring("login-amazon.example.com"); matches != nil {
+			t.Errorf("LookupSubstring(...) = %+v, want nil", matches)
+		}
+	})
+}
+
+func TestSkeletonIndexConcurrency(_ *testing.T) {
+	db := Default()
+	idx := db.NewSkeletonIndex()
+	idx.AddAll("brand", "paypal", "google", "amazon")
+
+	const (
+		goroutines = 50
+		iterations = 200
+	)
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+
+	for i := 0; i < goroutines; i++ {
+		go func(n int) {
+			defer wg.Done()
+			for j := 0; j < iterations; j++ {
+				idx.Add("example", "worker")
+				_ = idx.Lookup("pаypаl")
+				_ = idx.LookupSubstring("login-pаypаl.example.com")
+				_ = idx.Snapshot()
+				idx.Remove("example", "worker")
+			}
+		}(i)
+	}
+	wg.Wait()
+}